@@ -0,0 +1,221 @@
+// Package blackjack plays multi-hand blackjack (player vs. dealer) on
+// top of the deck package's shuffling and deck-building primitives.
+package blackjack
+
+import deck "github.com/MadsAkselsen/go-deck-of-cards"
+
+// Action is a choice a player can make while a hand is still live.
+type Action int
+
+const (
+	Hit Action = iota
+	Stand
+	Double
+	Split
+)
+
+// Result is the outcome of a single hand from the player's perspective.
+type Result int
+
+const (
+	Push Result = iota
+	Win
+	Loss
+	BlackjackWin
+)
+
+// Strategy decides the next Action for hand, given the dealer's single
+// face-up card. It is called again after every Hit, and after a Split
+// it is called independently for each resulting hand.
+type Strategy func(hand Hand, dealerUpCard deck.Card) Action
+
+// Stats tallies outcomes across every hand a Game has played, including
+// every hand produced by a Split.
+type Stats struct {
+	Wins, Losses, Pushes int
+}
+
+// Game deals from a shoe of NumDecks decks and plays one round at a
+// time via Play, accumulating running statistics in Stats.
+type Game struct {
+	// NumDecks is the number of 52-card decks the shoe is built from.
+	NumDecks int
+	// DealerHitsSoft17 controls whether the dealer hits or stands on a
+	// soft 17. The default, false, stands on soft 17.
+	DealerHitsSoft17 bool
+
+	Stats Stats
+
+	shuffle func([]deck.Card) []deck.Card
+	shoe    []deck.Card
+}
+
+// Option configures a Game built by New.
+type Option func(*Game)
+
+// WithShuffle sets the shuffle used to build and refill the shoe, such
+// as deck.ShuffleWithRand(r) for a reproducible Game in tests. The
+// default is deck.Shuffle.
+func WithShuffle(shuffle func([]deck.Card) []deck.Card) Option {
+	return func(g *Game) { g.shuffle = shuffle }
+}
+
+// New returns a Game with a freshly shuffled shoe of numDecks decks.
+func New(numDecks int, dealerHitsSoft17 bool, opts ...Option) *Game {
+	if numDecks < 1 {
+		numDecks = 1
+	}
+	g := &Game{
+		NumDecks:         numDecks,
+		DealerHitsSoft17: dealerHitsSoft17,
+		shuffle:          deck.Shuffle,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	g.refillShoe()
+	return g
+}
+
+// refillShoe rebuilds and reshuffles the shoe from scratch.
+func (g *Game) refillShoe() {
+	g.shoe = deck.New(deck.Deck(g.NumDecks), g.shuffle)
+}
+
+// draw removes and returns the top card of the shoe, reshuffling a new
+// shoe first if it has run out.
+func (g *Game) draw() deck.Card {
+	if len(g.shoe) == 0 {
+		g.refillShoe()
+	}
+	c := g.shoe[0]
+	g.shoe = g.shoe[1:]
+	return c
+}
+
+// splitHand is one of the (at most two) hands a split produces.
+type splitHand struct {
+	cards Hand
+	// busted is set once the hand goes over 21 and stops taking turns.
+	busted bool
+}
+
+// Play deals and plays a single round against the dealer, asking
+// strategy for the player's action at every decision point. It returns
+// the Result of the round and records it (and, in the case of a split,
+// every sub-hand's Result) into Stats.
+//
+// Hands may be split once: a split on an already-split hand is treated
+// as a Stand. Doubling deals exactly one more card and then stands.
+func (g *Game) Play(strategy Strategy) Result {
+	player := Hand{g.draw(), g.draw()}
+	dealer := Hand{g.draw(), g.draw()}
+
+	playerBJ, dealerBJ := IsBlackjack(player), IsBlackjack(dealer)
+	if playerBJ || dealerBJ {
+		result := Push
+		switch {
+		case playerBJ && !dealerBJ:
+			result = BlackjackWin
+		case dealerBJ && !playerBJ:
+			result = Loss
+		}
+		g.record(result)
+		return result
+	}
+
+	hands := g.playHands(player, dealer[0], strategy, true)
+
+	anyLive := false
+	for _, h := range hands {
+		if !h.busted {
+			anyLive = true
+		}
+	}
+	if anyLive {
+		dealer = g.playDealer(dealer)
+	}
+
+	results := make([]Result, len(hands))
+	for i, h := range hands {
+		results[i] = g.settle(h, dealer)
+		g.record(results[i])
+	}
+	return results[0]
+}
+
+// playHands plays out one hand, honoring Hit/Stand/Double and, when
+// allowSplit is true, a single Split into two sub-hands.
+func (g *Game) playHands(cards Hand, dealerUp deck.Card, strategy Strategy, allowSplit bool) []splitHand {
+	canSplit := allowSplit && len(cards) == 2 && cards[0].Rank == cards[1].Rank
+
+	for {
+		if IsBust(cards) {
+			return []splitHand{{cards: cards, busted: true}}
+		}
+		action := strategy(cards, dealerUp)
+		if canSplit && action == Split {
+			left := Hand{cards[0], g.draw()}
+			right := Hand{cards[1], g.draw()}
+			return append(
+				g.playHands(left, dealerUp, strategy, false),
+				g.playHands(right, dealerUp, strategy, false)...,
+			)
+		}
+		switch action {
+		case Hit:
+			cards = append(cards, g.draw())
+			canSplit = false
+		case Double:
+			cards = append(cards, g.draw())
+			return []splitHand{{cards: cards, busted: IsBust(cards)}}
+		case Stand, Split:
+			return []splitHand{{cards: cards}}
+		default:
+			return []splitHand{{cards: cards}}
+		}
+	}
+}
+
+// playDealer hits the dealer's hand until it reaches at least 17,
+// respecting DealerHitsSoft17.
+func (g *Game) playDealer(dealer Hand) Hand {
+	for {
+		score := Score(dealer)
+		if score > 17 || (score == 17 && !(g.DealerHitsSoft17 && IsSoft(dealer))) {
+			return dealer
+		}
+		dealer = append(dealer, g.draw())
+	}
+}
+
+// settle compares a finished player hand against the dealer's final
+// hand and returns the Result.
+func (g *Game) settle(h splitHand, dealer Hand) Result {
+	if h.busted {
+		return Loss
+	}
+	if IsBust(dealer) {
+		return Win
+	}
+	switch p, d := Score(h.cards), Score(dealer); {
+	case p > d:
+		return Win
+	case p < d:
+		return Loss
+	default:
+		return Push
+	}
+}
+
+// record folds result into the Game's running Stats.
+func (g *Game) record(result Result) {
+	switch result {
+	case Win, BlackjackWin:
+		g.Stats.Wins++
+	case Loss:
+		g.Stats.Losses++
+	case Push:
+		g.Stats.Pushes++
+	}
+}
@@ -0,0 +1,147 @@
+package blackjack
+
+import (
+	"math/rand"
+	"testing"
+
+	deck "github.com/MadsAkselsen/go-deck-of-cards"
+)
+
+func c(s deck.Suit, r deck.Rank) deck.Card { return deck.Card{Suit: s, Rank: r} }
+
+// fixedShoe returns a shuffle func that ignores its input and always
+// returns order, so a Game built with it deals a known sequence of
+// cards regardless of how many times the shoe is refilled.
+func fixedShoe(order []deck.Card) func([]deck.Card) []deck.Card {
+	return func([]deck.Card) []deck.Card { return order }
+}
+
+// TestSplitCallsStrategyOncePerHand guards against the regression where
+// a pocket pair's Split decision was queried twice: once to check for
+// Split and, when the strategy answered anything else, again from the
+// top of the decision loop. With the fix each of the three decision
+// points below - the initial pair, and each post-split hand - should
+// invoke strategy exactly once.
+func TestSplitCallsStrategyOncePerHand(t *testing.T) {
+	order := []deck.Card{
+		c(deck.Spade, deck.Eight), c(deck.Heart, deck.Eight), // player: 8,8
+		c(deck.Diamond, deck.Ten), c(deck.Club, deck.Seven), // dealer: T,7 = 17
+		c(deck.Diamond, deck.Three), // left split hand's extra card: 8+3=11
+		c(deck.Club, deck.Two),      // right split hand's extra card: 8+2=10
+	}
+	g := New(1, false, WithShuffle(fixedShoe(order)))
+
+	calls := 0
+	strategy := func(h Hand, up deck.Card) Action {
+		calls++
+		if len(h) == 2 && h[0].Rank == h[1].Rank {
+			return Split
+		}
+		return Stand
+	}
+
+	result := g.Play(strategy)
+
+	if calls != 3 {
+		t.Fatalf("strategy called %d times, want 3 (pair, left hand, right hand)", calls)
+	}
+	if result != Loss {
+		t.Fatalf("Play() = %v, want Loss (left hand)", result)
+	}
+	if g.Stats != (Stats{Losses: 2}) {
+		t.Fatalf("Stats = %+v, want both split hands to lose to dealer's 17", g.Stats)
+	}
+}
+
+// TestDoubleDealsOneCardThenStands checks that Double draws exactly one
+// card and settles without asking strategy again.
+func TestDoubleDealsOneCardThenStands(t *testing.T) {
+	order := []deck.Card{
+		c(deck.Spade, deck.Five), c(deck.Club, deck.Six), // player: 5,6 = 11
+		c(deck.Diamond, deck.Nine), c(deck.Heart, deck.Seven), // dealer: 9,7 = 16
+		c(deck.Diamond, deck.Five), // player's double card: 11+5=16
+		c(deck.Club, deck.Five),    // dealer's hit to reach 21
+	}
+	g := New(1, false, WithShuffle(fixedShoe(order)))
+
+	calls := 0
+	strategy := func(h Hand, up deck.Card) Action {
+		calls++
+		return Double
+	}
+
+	result := g.Play(strategy)
+
+	if calls != 1 {
+		t.Fatalf("strategy called %d times, want 1", calls)
+	}
+	if result != Loss {
+		t.Fatalf("Play() = %v, want Loss (player 16 vs dealer 21)", result)
+	}
+}
+
+// TestBustIsAnAutomaticLoss checks that a player hand which busts stops
+// taking hits and loses without the dealer needing to play.
+func TestBustIsAnAutomaticLoss(t *testing.T) {
+	order := []deck.Card{
+		c(deck.Spade, deck.Ten), c(deck.Club, deck.Six), // player: T,6 = 16
+		c(deck.Diamond, deck.Ten), c(deck.Heart, deck.Six), // dealer: T,6 = 16
+		c(deck.Club, deck.Ten), // player's hit: 16+10=26, bust
+	}
+	g := New(1, false, WithShuffle(fixedShoe(order)))
+
+	result := g.Play(func(h Hand, up deck.Card) Action { return Hit })
+
+	if result != Loss {
+		t.Fatalf("Play() = %v, want Loss", result)
+	}
+	if g.Stats != (Stats{Losses: 1}) {
+		t.Fatalf("Stats = %+v, want a single recorded loss", g.Stats)
+	}
+}
+
+// TestDealerHitsSoft17 checks the DealerHitsSoft17 option: a dealer
+// showing a soft 17 (Ace, Six) stands with it off and hits with it on.
+func TestDealerHitsSoft17(t *testing.T) {
+	order := []deck.Card{
+		c(deck.Spade, deck.King), c(deck.Club, deck.Ten), // player: K,T = 20
+		c(deck.Heart, deck.Ace), c(deck.Diamond, deck.Six), // dealer: A,6 = soft 17
+		c(deck.Spade, deck.Four), // dealer's hit if it doesn't stand: soft17+4=21
+	}
+	stand := func(Hand, deck.Card) Action { return Stand }
+
+	standsOnSoft17 := New(1, false, WithShuffle(fixedShoe(order)))
+	if result := standsOnSoft17.Play(stand); result != Win {
+		t.Fatalf("DealerHitsSoft17=false: Play() = %v, want Win (dealer stands on 17)", result)
+	}
+
+	hitsOnSoft17 := New(1, true, WithShuffle(fixedShoe(order)))
+	if result := hitsOnSoft17.Play(stand); result != Loss {
+		t.Fatalf("DealerHitsSoft17=true: Play() = %v, want Loss (dealer hits soft 17 to 21)", result)
+	}
+}
+
+// TestWithShuffleIsReproducible checks that two Games built with the
+// same deck.ShuffleWithRand seed play identically, which is the whole
+// point of exposing WithShuffle.
+func TestWithShuffleIsReproducible(t *testing.T) {
+	newGame := func() *Game {
+		return New(4, false, WithShuffle(deck.ShuffleWithRand(rand.New(rand.NewSource(42)))))
+	}
+	strategy := func(h Hand, up deck.Card) Action {
+		if Score(h) < 17 {
+			return Hit
+		}
+		return Stand
+	}
+
+	a, b := newGame(), newGame()
+	for i := 0; i < 200; i++ {
+		a.Play(strategy)
+		b.Play(strategy)
+	}
+
+	if a.Stats != b.Stats {
+		t.Fatalf("Stats diverged with the same seed: %+v vs %+v", a.Stats, b.Stats)
+	}
+}
@@ -0,0 +1,59 @@
+package blackjack
+
+import deck "github.com/MadsAkselsen/go-deck-of-cards"
+
+// Hand is the set of cards currently held by a player or the dealer.
+type Hand []deck.Card
+
+// rankValue returns the blackjack value of a single rank, ignoring the
+// Ace's dual 1-or-11 value, which Score handles separately.
+func rankValue(r deck.Rank) int {
+	switch {
+	case r == deck.Ace:
+		return 1
+	case r >= deck.Ten:
+		return 10
+	default:
+		return int(r)
+	}
+}
+
+// Score returns the best non-busting total for hand, treating each Ace
+// as 1 or 11 - whichever keeps the total highest without going over 21.
+// If every total is over 21, it returns the lowest one (all Aces as 1).
+func Score(hand Hand) int {
+	total, aces := 0, 0
+	for _, c := range hand {
+		if c.Rank == deck.Ace {
+			aces++
+		}
+		total += rankValue(c.Rank)
+	}
+	for aces > 0 && total+10 <= 21 {
+		total += 10
+		aces--
+	}
+	return total
+}
+
+// IsSoft reports whether hand's best score counts an Ace as 11.
+func IsSoft(hand Hand) bool {
+	total, aces := 0, 0
+	for _, c := range hand {
+		if c.Rank == deck.Ace {
+			aces++
+		}
+		total += rankValue(c.Rank)
+	}
+	return aces > 0 && total+10 <= 21
+}
+
+// IsBust reports whether hand's score is over 21.
+func IsBust(hand Hand) bool {
+	return Score(hand) > 21
+}
+
+// IsBlackjack reports whether hand is a two-card 21.
+func IsBlackjack(hand Hand) bool {
+	return len(hand) == 2 && Score(hand) == 21
+}
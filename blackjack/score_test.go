@@ -0,0 +1,73 @@
+package blackjack
+
+import (
+	"testing"
+
+	deck "github.com/MadsAkselsen/go-deck-of-cards"
+)
+
+// TestScoreAndIsSoftMultiAce pins down the Ace = 1 or 11 promotion loop
+// for hands holding more than one Ace, which Score/IsSoft only ever saw
+// indirectly through full games dealing at most one Ace per hand.
+func TestScoreAndIsSoftMultiAce(t *testing.T) {
+	tests := []struct {
+		name      string
+		hand      Hand
+		wantScore int
+		wantSoft  bool
+	}{
+		{
+			name:      "A+A",
+			hand:      Hand{c(deck.Spade, deck.Ace), c(deck.Heart, deck.Ace)},
+			wantScore: 12,
+			wantSoft:  true,
+		},
+		{
+			name:      "A+A+9",
+			hand:      Hand{c(deck.Spade, deck.Ace), c(deck.Heart, deck.Ace), c(deck.Club, deck.Nine)},
+			wantScore: 21,
+			wantSoft:  true,
+		},
+		{
+			name:      "A+A+A",
+			hand:      Hand{c(deck.Spade, deck.Ace), c(deck.Heart, deck.Ace), c(deck.Club, deck.Ace)},
+			wantScore: 13,
+			wantSoft:  true,
+		},
+		{
+			name:      "A+A+K",
+			hand:      Hand{c(deck.Spade, deck.Ace), c(deck.Heart, deck.Ace), c(deck.Club, deck.King)},
+			wantScore: 12,
+			wantSoft:  false,
+		},
+		{
+			name:      "A alone",
+			hand:      Hand{c(deck.Spade, deck.Ace)},
+			wantScore: 11,
+			wantSoft:  true,
+		},
+		{
+			name:      "A+K (blackjack)",
+			hand:      Hand{c(deck.Spade, deck.Ace), c(deck.Heart, deck.King)},
+			wantScore: 21,
+			wantSoft:  true,
+		},
+		{
+			name:      "A+9+A+A (three aces and a nine)",
+			hand:      Hand{c(deck.Spade, deck.Ace), c(deck.Club, deck.Nine), c(deck.Heart, deck.Ace), c(deck.Diamond, deck.Ace)},
+			wantScore: 12,
+			wantSoft:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Score(tt.hand); got != tt.wantScore {
+				t.Errorf("Score(%v) = %d, want %d", tt.hand, got, tt.wantScore)
+			}
+			if got := IsSoft(tt.hand); got != tt.wantSoft {
+				t.Errorf("IsSoft(%v) = %v, want %v", tt.hand, got, tt.wantSoft)
+			}
+		})
+	}
+}
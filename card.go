@@ -3,10 +3,11 @@
 package deck
 
 import (
+	crand "crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"sort"
-	"time"
 )
 
 type Suit uint8
@@ -117,22 +118,40 @@ func absRank(c Card) int {
 	return int(c.Suit) * int(maxRank) + int(c.Rank)
 }
 
-var shuffleRand = rand.New(rand.NewSource(time.Now().Unix()))
+var shuffleRand = rand.New(rand.NewSource(cryptoSeed()))
+
+// cryptoSeed reads a seed from crypto/rand rather than time.Now(), so
+// that two decks created within the same second don't shuffle
+// identically.
+func cryptoSeed() int64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		// crypto/rand failing means the OS's CSPRNG is unavailable;
+		// fall back to an arbitrary fixed seed rather than panicking.
+		return 1
+	}
+	return int64(binary.LittleEndian.Uint64(b[:]))
+}
 
+// Shuffle randomizes the order of cards in place using Fisher-Yates
+// (via rand.Shuffle), and returns it for chaining with New.
 func Shuffle(cards []Card) []Card {
-	ret := make([]Card, len(cards))
-	// Perm is is a method that shuffles items in an array.
-	// For example, [1,2,3,4,5] can become [1,3,5,2,4]
-	// the 'shuffleRand' is the source, and the source dictates
-	// the resulting order. If the source is always the same, the
-	// order is always the same. But with the above provided time.Now()
-	// the source is always different and thus the shuffle is always different.
-	perm := shuffleRand.Perm(len(cards))
-	// assigning the shuffled indexes to the cards array
-	for i, j := range perm {
-		ret[i] = cards[j]
+	shuffleRand.Shuffle(len(cards), func(i, j int) {
+		cards[i], cards[j] = cards[j], cards[i]
+	})
+	return cards
+}
+
+// ShuffleWithRand returns a New option that shuffles with r instead of
+// the package's default randomly-seeded source, making games built on
+// New reproducible in tests by passing a fixed-seed *rand.Rand.
+func ShuffleWithRand(r *rand.Rand) func([]Card) []Card {
+	return func(cards []Card) []Card {
+		r.Shuffle(len(cards), func(i, j int) {
+			cards[i], cards[j] = cards[j], cards[i]
+		})
+		return cards
 	}
-	return ret
 }
 
 func Jokers(n int) func([]Card) []Card {
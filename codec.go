@@ -0,0 +1,95 @@
+package deck
+
+import (
+	"fmt"
+	"strings"
+)
+
+// jokerGlyph is the Unicode playing-card glyph used by Card.Unicode
+// for Jokers.
+const jokerGlyph = "\U0001F0CF"
+
+// rankChars and suitChars map Rank/Suit to the single-character codes
+// used by Short, Unicode, ParseCard, and ParseCards.
+var rankChars = map[Rank]byte{
+	Ace: 'A', Two: '2', Three: '3', Four: '4', Five: '5', Six: '6',
+	Seven: '7', Eight: '8', Nine: '9', Ten: 'T', Jack: 'J', Queen: 'Q', King: 'K',
+}
+
+var suitChars = map[Suit]byte{
+	Spade: 's', Diamond: 'd', Club: 'c', Heart: 'h',
+}
+
+var suitGlyphs = map[Suit]string{
+	Spade: "♠", Diamond: "♦", Club: "♣", Heart: "♥",
+}
+
+var charRanks = func() map[byte]Rank {
+	m := make(map[byte]Rank, len(rankChars))
+	for r, ch := range rankChars {
+		m[ch] = r
+	}
+	return m
+}()
+
+var charSuits = func() map[byte]Suit {
+	m := make(map[byte]Suit, len(suitChars))
+	for s, ch := range suitChars {
+		m[ch] = s
+	}
+	return m
+}()
+
+// Short returns a 2-character code for c, such as "As", "Td", or "Kh"
+// - the rank character followed by the suit letter. Jokers render as
+// "Jk".
+func (c Card) Short() string {
+	if c.Suit == Joker {
+		return "Jk"
+	}
+	return string(rankChars[c.Rank]) + string(suitChars[c.Suit])
+}
+
+// Unicode returns c rendered with its suit as a glyph, such as "A♠".
+// Jokers render as the Unicode Joker playing-card glyph.
+func (c Card) Unicode() string {
+	if c.Suit == Joker {
+		return jokerGlyph
+	}
+	return string(rankChars[c.Rank]) + suitGlyphs[c.Suit]
+}
+
+// ParseCard parses a 2-character short code such as "As" or "Td" (see
+// Short) back into a Card. "Jk" parses as a Joker.
+func ParseCard(s string) (Card, error) {
+	if s == "Jk" {
+		return Card{Suit: Joker}, nil
+	}
+	if len(s) != 2 {
+		return Card{}, fmt.Errorf("deck: invalid card %q: want a 2-character code like \"As\"", s)
+	}
+	rank, ok := charRanks[s[0]]
+	if !ok {
+		return Card{}, fmt.Errorf("deck: invalid rank in card %q", s)
+	}
+	suit, ok := charSuits[s[1]]
+	if !ok {
+		return Card{}, fmt.Errorf("deck: invalid suit in card %q", s)
+	}
+	return Card{Suit: suit, Rank: rank}, nil
+}
+
+// ParseCards parses a comma-separated list of short codes, such as
+// "As,Td,2h", into a slice of Cards.
+func ParseCards(s string) ([]Card, error) {
+	parts := strings.Split(s, ",")
+	cards := make([]Card, len(parts))
+	for i, p := range parts {
+		card, err := ParseCard(strings.TrimSpace(p))
+		if err != nil {
+			return nil, err
+		}
+		cards[i] = card
+	}
+	return cards, nil
+}
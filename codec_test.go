@@ -0,0 +1,67 @@
+package deck
+
+import "testing"
+
+func TestShortAndUnicode(t *testing.T) {
+	tests := []struct {
+		card      Card
+		wantShort string
+		wantGlyph string
+	}{
+		{Card{Suit: Spade, Rank: Ace}, "As", "A♠"},
+		{Card{Suit: Diamond, Rank: Ten}, "Td", "T♦"},
+		{Card{Suit: Heart, Rank: King}, "Kh", "K♥"},
+		{Card{Suit: Club, Rank: Two}, "2c", "2♣"},
+		{Card{Suit: Joker}, "Jk", jokerGlyph},
+	}
+
+	for _, tt := range tests {
+		if got := tt.card.Short(); got != tt.wantShort {
+			t.Errorf("%v.Short() = %q, want %q", tt.card, got, tt.wantShort)
+		}
+		if got := tt.card.Unicode(); got != tt.wantGlyph {
+			t.Errorf("%v.Unicode() = %q, want %q", tt.card, got, tt.wantGlyph)
+		}
+	}
+}
+
+func TestParseCardRoundTrip(t *testing.T) {
+	for _, card := range New() {
+		parsed, err := ParseCard(card.Short())
+		if err != nil {
+			t.Fatalf("ParseCard(%q): %v", card.Short(), err)
+		}
+		if parsed != card {
+			t.Fatalf("ParseCard(%q) = %v, want %v", card.Short(), parsed, card)
+		}
+	}
+}
+
+func TestParseCardErrors(t *testing.T) {
+	for _, s := range []string{"", "A", "Asd", "Zs", "Az"} {
+		if _, err := ParseCard(s); err == nil {
+			t.Errorf("ParseCard(%q) returned no error", s)
+		}
+	}
+}
+
+func TestParseCards(t *testing.T) {
+	cards, err := ParseCards("As, Td, 2h")
+	if err != nil {
+		t.Fatalf("ParseCards: %v", err)
+	}
+	want := []Card{
+		{Suit: Spade, Rank: Ace},
+		{Suit: Diamond, Rank: Ten},
+		{Suit: Heart, Rank: Two},
+	}
+	for i, c := range want {
+		if cards[i] != c {
+			t.Fatalf("ParseCards()[%d] = %v, want %v", i, cards[i], c)
+		}
+	}
+
+	if _, err := ParseCards("As,Zz"); err == nil {
+		t.Fatal("ParseCards with an invalid code returned no error")
+	}
+}
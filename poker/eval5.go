@@ -0,0 +1,108 @@
+package poker
+
+import (
+	"sort"
+
+	deck "github.com/MadsAkselsen/go-deck-of-cards"
+)
+
+// rankValue returns a card's rank for poker purposes, where Ace always
+// outranks King (14), except when it's the low card of a wheel
+// straight - that case is handled separately in straightHighCard.
+func rankValue(r deck.Rank) int {
+	if r == deck.Ace {
+		return 14
+	}
+	return int(r)
+}
+
+// straightHighCard reports whether a 5-card hand contains a straight
+// and, if so, the value of its highest card. Straights are found with
+// a rank bitmask: bit 1 is the Ace counted low, bits 2-13 are Two
+// through King, and bit 14 is the Ace counted high. ORing the Ace into
+// bit 1 as well as bit 14 is what lets the wheel (A-2-3-4-5) match the
+// same 5-consecutive-bits check as every other straight.
+func straightHighCard(cards []deck.Card) (int, bool) {
+	var mask uint16
+	for _, c := range cards {
+		if c.Rank == deck.Ace {
+			mask |= 1<<1 | 1<<14
+			continue
+		}
+		mask |= 1 << uint(c.Rank)
+	}
+
+	for s := 10; s >= 1; s-- {
+		window := uint16(0b11111) << uint(s)
+		if mask&window == window {
+			return s + 4, true
+		}
+	}
+	return 0, false
+}
+
+// evaluate5 categorizes exactly 5 cards and returns a tiebreaker score
+// that packs the significant ranks, most significant first, 4 bits
+// each, so two hands of the same Category compare correctly as plain
+// integers.
+func evaluate5(cards []deck.Card) (Category, int) {
+	counts := map[int]int{}
+	suits := map[deck.Suit]int{}
+	for _, c := range cards {
+		counts[rankValue(c.Rank)]++
+		suits[c.Suit]++
+	}
+	flush := len(suits) == 1
+	straightHigh, isStraight := straightHighCard(cards)
+
+	type group struct{ rank, count int }
+	groups := make([]group, 0, len(counts))
+	for r, n := range counts {
+		groups = append(groups, group{r, n})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].count != groups[j].count {
+			return groups[i].count > groups[j].count
+		}
+		return groups[i].rank > groups[j].rank
+	})
+
+	score := func(ranks ...int) int {
+		s := 0
+		for _, r := range ranks {
+			s = s<<4 | r
+		}
+		return s
+	}
+	groupRanks := func() []int {
+		ranks := make([]int, len(groups))
+		for i, g := range groups {
+			ranks[i] = g.rank
+		}
+		return ranks
+	}
+
+	switch {
+	case flush && isStraight:
+		if straightHigh == 14 {
+			return RoyalFlush, score(straightHigh)
+		}
+		return StraightFlush, score(straightHigh)
+	case groups[0].count == 4:
+		return FourOfAKind, score(groupRanks()...)
+	case groups[0].count == 3 && groups[1].count >= 2:
+		return FullHouse, score(groups[0].rank, groups[1].rank)
+	case flush:
+		return Flush, score(groupRanks()...)
+	case isStraight:
+		return Straight, score(straightHigh)
+	case groups[0].count == 3:
+		return ThreeOfAKind, score(groupRanks()...)
+	case groups[0].count == 2 && groups[1].count == 2:
+		return TwoPair, score(groupRanks()...)
+	case groups[0].count == 2:
+		return Pair, score(groupRanks()...)
+	default:
+		return HighCard, score(groupRanks()...)
+	}
+}
@@ -0,0 +1,121 @@
+// Package poker evaluates poker hands built from deck.Card, ranking
+// 5-card and 7-card hands (Texas Hold'em, Omaha, and similar games) into
+// a Category plus a tiebreaker score.
+package poker
+
+import (
+	"fmt"
+
+	deck "github.com/MadsAkselsen/go-deck-of-cards"
+)
+
+// Category is a standard poker hand ranking, from weakest to strongest.
+type Category int
+
+const (
+	HighCard Category = iota
+	Pair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
+	RoyalFlush
+)
+
+func (c Category) String() string {
+	switch c {
+	case HighCard:
+		return "High Card"
+	case Pair:
+		return "Pair"
+	case TwoPair:
+		return "Two Pair"
+	case ThreeOfAKind:
+		return "Three of a Kind"
+	case Straight:
+		return "Straight"
+	case Flush:
+		return "Flush"
+	case FullHouse:
+		return "Full House"
+	case FourOfAKind:
+		return "Four of a Kind"
+	case StraightFlush:
+		return "Straight Flush"
+	case RoyalFlush:
+		return "Royal Flush"
+	default:
+		return "Unknown"
+	}
+}
+
+// Evaluate ranks a 5- or 7-card hand, returning its Category and a
+// tiebreaker score. Within the same Category, a higher score always
+// beats a lower one. For a 7-card hand, every C(7,5)=21 five-card
+// subset is scored and the best one wins, as in Texas Hold'em and
+// Omaha where the hole cards and community cards combine.
+//
+// Evaluate panics if cards has fewer than 5 cards - there is no valid
+// poker hand to rank.
+func Evaluate(cards []deck.Card) (Category, int) {
+	if len(cards) < 5 {
+		panic(fmt.Sprintf("poker: Evaluate requires at least 5 cards, got %d", len(cards)))
+	}
+
+	best := Category(-1)
+	bestScore := -1
+	for _, five := range fiveCardSubsets(cards) {
+		cat, score := evaluate5(five)
+		if cat > best || (cat == best && score > bestScore) {
+			best, bestScore = cat, score
+		}
+	}
+	return best, bestScore
+}
+
+// Compare ranks two hands, returning -1 if a is weaker than b, 1 if a
+// is stronger than b, and 0 on an exact tie.
+func Compare(a, b []deck.Card) int {
+	catA, scoreA := Evaluate(a)
+	catB, scoreB := Evaluate(b)
+	switch {
+	case catA != catB:
+		if catA < catB {
+			return -1
+		}
+		return 1
+	case scoreA < scoreB:
+		return -1
+	case scoreA > scoreB:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// fiveCardSubsets returns every 5-card combination of cards. The
+// caller guarantees len(cards) >= 5.
+func fiveCardSubsets(cards []deck.Card) [][]deck.Card {
+	if len(cards) == 5 {
+		return [][]deck.Card{cards}
+	}
+
+	var subsets [][]deck.Card
+	var choose func(start int, picked []deck.Card)
+	choose = func(start int, picked []deck.Card) {
+		if len(picked) == 5 {
+			combo := make([]deck.Card, 5)
+			copy(combo, picked)
+			subsets = append(subsets, combo)
+			return
+		}
+		for i := start; i < len(cards); i++ {
+			choose(i+1, append(picked, cards[i]))
+		}
+	}
+	choose(0, make([]deck.Card, 0, 5))
+	return subsets
+}
@@ -0,0 +1,132 @@
+package poker
+
+import (
+	"testing"
+
+	deck "github.com/MadsAkselsen/go-deck-of-cards"
+)
+
+func c(s deck.Suit, r deck.Rank) deck.Card { return deck.Card{Suit: s, Rank: r} }
+
+func TestEvaluateCategories(t *testing.T) {
+	tests := []struct {
+		name string
+		hand []deck.Card
+		want Category
+	}{
+		{
+			name: "high card",
+			hand: []deck.Card{c(deck.Spade, deck.Two), c(deck.Club, deck.Five), c(deck.Diamond, deck.Nine), c(deck.Heart, deck.Jack), c(deck.Spade, deck.King)},
+			want: HighCard,
+		},
+		{
+			name: "pair",
+			hand: []deck.Card{c(deck.Spade, deck.Five), c(deck.Club, deck.Five), c(deck.Diamond, deck.Nine), c(deck.Heart, deck.Jack), c(deck.Spade, deck.King)},
+			want: Pair,
+		},
+		{
+			name: "two pair",
+			hand: []deck.Card{c(deck.Spade, deck.Five), c(deck.Club, deck.Five), c(deck.Diamond, deck.Nine), c(deck.Heart, deck.Nine), c(deck.Spade, deck.King)},
+			want: TwoPair,
+		},
+		{
+			name: "three of a kind",
+			hand: []deck.Card{c(deck.Spade, deck.Five), c(deck.Club, deck.Five), c(deck.Diamond, deck.Five), c(deck.Heart, deck.Nine), c(deck.Spade, deck.King)},
+			want: ThreeOfAKind,
+		},
+		{
+			name: "straight",
+			hand: []deck.Card{c(deck.Spade, deck.Five), c(deck.Club, deck.Six), c(deck.Diamond, deck.Seven), c(deck.Heart, deck.Eight), c(deck.Spade, deck.Nine)},
+			want: Straight,
+		},
+		{
+			name: "wheel straight (A-2-3-4-5)",
+			hand: []deck.Card{c(deck.Heart, deck.Ace), c(deck.Club, deck.Two), c(deck.Diamond, deck.Three), c(deck.Spade, deck.Four), c(deck.Heart, deck.Five)},
+			want: Straight,
+		},
+		{
+			name: "flush",
+			hand: []deck.Card{c(deck.Spade, deck.Two), c(deck.Spade, deck.Five), c(deck.Spade, deck.Nine), c(deck.Spade, deck.Jack), c(deck.Spade, deck.King)},
+			want: Flush,
+		},
+		{
+			name: "full house",
+			hand: []deck.Card{c(deck.Spade, deck.Three), c(deck.Club, deck.Three), c(deck.Diamond, deck.Three), c(deck.Heart, deck.Eight), c(deck.Spade, deck.Eight)},
+			want: FullHouse,
+		},
+		{
+			name: "four of a kind",
+			hand: []deck.Card{c(deck.Spade, deck.Nine), c(deck.Club, deck.Nine), c(deck.Diamond, deck.Nine), c(deck.Heart, deck.Nine), c(deck.Spade, deck.Two)},
+			want: FourOfAKind,
+		},
+		{
+			name: "straight flush",
+			hand: []deck.Card{c(deck.Club, deck.Five), c(deck.Club, deck.Six), c(deck.Club, deck.Seven), c(deck.Club, deck.Eight), c(deck.Club, deck.Nine)},
+			want: StraightFlush,
+		},
+		{
+			name: "royal flush",
+			hand: []deck.Card{c(deck.Spade, deck.Ten), c(deck.Spade, deck.Jack), c(deck.Spade, deck.Queen), c(deck.Spade, deck.King), c(deck.Spade, deck.Ace)},
+			want: RoyalFlush,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cat, _ := Evaluate(tt.hand)
+			if cat != tt.want {
+				t.Fatalf("Evaluate() category = %v, want %v", cat, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateWheelRanksBelowSixHighStraight(t *testing.T) {
+	wheel := []deck.Card{c(deck.Heart, deck.Ace), c(deck.Club, deck.Two), c(deck.Diamond, deck.Three), c(deck.Spade, deck.Four), c(deck.Heart, deck.Five)}
+	sixHigh := []deck.Card{c(deck.Heart, deck.Two), c(deck.Club, deck.Three), c(deck.Diamond, deck.Four), c(deck.Spade, deck.Five), c(deck.Heart, deck.Six)}
+
+	if got := Compare(wheel, sixHigh); got != -1 {
+		t.Fatalf("Compare(wheel, sixHigh) = %d, want -1 (Ace counts low in a wheel)", got)
+	}
+}
+
+func TestEvaluateSevenCardBestOfSubsets(t *testing.T) {
+	// Two hole cards (King, King) plus a five-card board that already
+	// contains a pair of eights: the best 5-card hand is two pair,
+	// kings and eights, not merely the weaker hand using all 7 cards.
+	seven := []deck.Card{
+		c(deck.Spade, deck.King), c(deck.Club, deck.King),
+		c(deck.Diamond, deck.Eight), c(deck.Heart, deck.Eight),
+		c(deck.Spade, deck.Two), c(deck.Club, deck.Five), c(deck.Diamond, deck.Nine),
+	}
+
+	cat, _ := Evaluate(seven)
+	if cat != TwoPair {
+		t.Fatalf("Evaluate() category = %v, want TwoPair", cat)
+	}
+}
+
+func TestCompareOrdersCategories(t *testing.T) {
+	straightFlush := []deck.Card{c(deck.Club, deck.Five), c(deck.Club, deck.Six), c(deck.Club, deck.Seven), c(deck.Club, deck.Eight), c(deck.Club, deck.Nine)}
+	quads := []deck.Card{c(deck.Spade, deck.Nine), c(deck.Club, deck.Nine), c(deck.Diamond, deck.Nine), c(deck.Heart, deck.Nine), c(deck.Spade, deck.Two)}
+	fullHouse := []deck.Card{c(deck.Spade, deck.Three), c(deck.Club, deck.Three), c(deck.Diamond, deck.Three), c(deck.Heart, deck.Eight), c(deck.Spade, deck.Eight)}
+
+	if got := Compare(straightFlush, quads); got != 1 {
+		t.Fatalf("Compare(straightFlush, quads) = %d, want 1", got)
+	}
+	if got := Compare(quads, fullHouse); got != 1 {
+		t.Fatalf("Compare(quads, fullHouse) = %d, want 1", got)
+	}
+	if got := Compare(fullHouse, fullHouse); got != 0 {
+		t.Fatalf("Compare(fullHouse, fullHouse) = %d, want 0", got)
+	}
+}
+
+func TestEvaluatePanicsOnShortHand(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Evaluate() with 3 cards did not panic")
+		}
+	}()
+	Evaluate([]deck.Card{c(deck.Spade, deck.Three), c(deck.Club, deck.Three), c(deck.Diamond, deck.Three)})
+}
@@ -0,0 +1,136 @@
+package deck
+
+import "errors"
+
+// Shoe is a stateful multi-deck card shoe with a cut card, burned
+// cards, and automatic reshuffling - what casino-style games such as
+// blackjack and baccarat deal from, as opposed to a single one-off
+// []Card built by New.
+type Shoe struct {
+	numDecks    int
+	burnCount   int
+	penetration float64
+	shuffle     func([]Card) []Card
+
+	cards     []Card
+	pos       int
+	afterBurn int // pos immediately after the last fill's burn
+}
+
+// ShoeOption configures a Shoe built by NewShoe.
+type ShoeOption func(*Shoe)
+
+// ShoeCutCard sets the point, as a fraction of the shoe's total cards,
+// at which drawing triggers an automatic reshuffle. The default is
+// 0.75.
+func ShoeCutCard(penetration float64) ShoeOption {
+	return func(s *Shoe) { s.penetration = penetration }
+}
+
+// ShoeBurn sets how many cards are burned (dealt and discarded) each
+// time the shoe is filled or reshuffled. The default is 1. A negative n
+// is clamped to 0.
+func ShoeBurn(n int) ShoeOption {
+	if n < 0 {
+		n = 0
+	}
+	return func(s *Shoe) { s.burnCount = n }
+}
+
+// ShoeShuffle sets the shuffle function used to build and reshuffle the
+// shoe, such as ShuffleWithRand(r) for a reproducible shoe. The default
+// is Shuffle.
+func ShoeShuffle(shuffle func([]Card) []Card) ShoeOption {
+	return func(s *Shoe) { s.shuffle = shuffle }
+}
+
+// NewShoe returns a Shoe built from numDecks decks, shuffled and burned
+// according to opts.
+func NewShoe(numDecks int, opts ...ShoeOption) *Shoe {
+	if numDecks < 1 {
+		numDecks = 1
+	}
+	s := &Shoe{
+		numDecks:    numDecks,
+		burnCount:   1,
+		penetration: 0.75,
+		shuffle:     Shuffle,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.fill()
+	return s
+}
+
+// fill rebuilds the shoe from numDecks fresh decks, shuffles it, and
+// burns burnCount cards.
+func (s *Shoe) fill() {
+	s.cards = New(Deck(s.numDecks), s.shuffle)
+	s.pos = s.burnCount
+	if s.pos > len(s.cards) {
+		s.pos = len(s.cards)
+	}
+	s.afterBurn = s.pos
+}
+
+// cutCardPos is the index into s.cards at which the cut card sits. When
+// a ShoeBurn/ShoeCutCard combination would put the cut card at or before
+// the burned cards, it moves to the end of the shoe instead, so every
+// card after the burn is dealt before a reshuffle rather than forcing a
+// reshuffle on every single Draw.
+func (s *Shoe) cutCardPos() int {
+	pos := int(float64(len(s.cards)) * s.penetration)
+	if pos <= s.afterBurn {
+		pos = len(s.cards)
+	}
+	if pos > len(s.cards) {
+		pos = len(s.cards)
+	}
+	return pos
+}
+
+// Draw removes and returns the next card from the shoe, automatically
+// reshuffling a fresh shoe first if the cut card has been reached.
+func (s *Shoe) Draw() (Card, error) {
+	if s.pos >= s.cutCardPos() {
+		s.fill()
+	}
+	if s.pos >= len(s.cards) {
+		return Card{}, errors.New("deck: shoe is empty")
+	}
+	c := s.cards[s.pos]
+	s.pos++
+	return c, nil
+}
+
+// DrawN draws the next n cards from the shoe.
+func (s *Shoe) DrawN(n int) ([]Card, error) {
+	if n < 0 {
+		return nil, errors.New("deck: DrawN called with a negative n")
+	}
+	cards := make([]Card, n)
+	for i := range cards {
+		c, err := s.Draw()
+		if err != nil {
+			return nil, err
+		}
+		cards[i] = c
+	}
+	return cards, nil
+}
+
+// Remaining returns how many cards are left to draw before the shoe
+// automatically reshuffles at the cut card.
+func (s *Shoe) Remaining() int {
+	if r := s.cutCardPos() - s.pos; r > 0 {
+		return r
+	}
+	return 0
+}
+
+// Penetration returns the fraction, between 0 and 1, of the shoe that
+// has been dealt since the last reshuffle.
+func (s *Shoe) Penetration() float64 {
+	return float64(s.pos) / float64(len(s.cards))
+}
@@ -0,0 +1,110 @@
+package deck
+
+import "testing"
+
+func TestShoeBurnsOnFill(t *testing.T) {
+	s := NewShoe(1, ShoeBurn(5))
+	if s.pos != 5 {
+		t.Fatalf("pos after fill = %d, want 5 burned cards", s.pos)
+	}
+}
+
+func TestShoeReshufflesAtCutCard(t *testing.T) {
+	s := NewShoe(1, ShoeBurn(0), ShoeCutCard(0.5))
+	if want := 26; s.cutCardPos() != want {
+		t.Fatalf("cutCardPos() = %d, want %d", s.cutCardPos(), want)
+	}
+
+	reshuffles := 0
+	prevPenetration := s.Penetration()
+	for i := 0; i < 100; i++ {
+		if _, err := s.Draw(); err != nil {
+			t.Fatalf("Draw() #%d: %v", i, err)
+		}
+		if p := s.Penetration(); p < prevPenetration {
+			reshuffles++
+		} else {
+			prevPenetration = p
+		}
+	}
+	if reshuffles == 0 {
+		t.Fatal("shoe never reshuffled after 100 draws past its cut card")
+	}
+}
+
+// TestShoeBurnAtOrPastCutCardDoesNotReshuffleEveryDraw guards against
+// the regression where a ShoeBurn count at or past the cut card's
+// computed position caused every single Draw to reshuffle (and
+// re-burn) instead of dealing normally.
+func TestShoeBurnAtOrPastCutCardDoesNotReshuffleEveryDraw(t *testing.T) {
+	s := NewShoe(1, ShoeBurn(45), ShoeCutCard(0.5)) // cut card would be at 26, before the burn
+	prevPenetration := s.Penetration()
+	reshuffles := 0
+	for i := 0; i < 20; i++ {
+		if _, err := s.Draw(); err != nil {
+			t.Fatalf("Draw() #%d: %v", i, err)
+		}
+		if p := s.Penetration(); p < prevPenetration {
+			reshuffles++
+		}
+		prevPenetration = s.Penetration()
+	}
+	// 52-45 = 7 cards are dealt per shoe, so the reshuffles come on
+	// draws 8 and 15.
+	if reshuffles != 2 {
+		t.Fatalf("shoe reshuffled %d times in 20 draws, want 2 (7 cards dealt after each burn)", reshuffles)
+	}
+}
+
+func TestShoeRemaining(t *testing.T) {
+	s := NewShoe(1, ShoeBurn(0), ShoeCutCard(0.5))
+	if want := 26; s.Remaining() != want {
+		t.Fatalf("Remaining() after fill = %d, want %d", s.Remaining(), want)
+	}
+
+	for i := 0; i < 10; i++ {
+		if _, err := s.Draw(); err != nil {
+			t.Fatalf("Draw() #%d: %v", i, err)
+		}
+	}
+	if want := 16; s.Remaining() != want {
+		t.Fatalf("Remaining() after 10 draws = %d, want %d", s.Remaining(), want)
+	}
+
+	for i := 0; i < 16; i++ {
+		if _, err := s.Draw(); err != nil {
+			t.Fatalf("Draw() #%d: %v", i, err)
+		}
+	}
+	if s.Remaining() != 0 {
+		t.Fatalf("Remaining() at the cut card = %d, want 0", s.Remaining())
+	}
+}
+
+func TestShoeBurnClampsNegativeN(t *testing.T) {
+	s := NewShoe(1, ShoeBurn(-5))
+	if s.pos < 0 {
+		t.Fatalf("pos after fill = %d, want a non-negative burn count", s.pos)
+	}
+	if _, err := s.Draw(); err != nil {
+		t.Fatalf("Draw() after ShoeBurn(-5): %v", err)
+	}
+}
+
+func TestShoeDrawNNegativeIsAnError(t *testing.T) {
+	s := NewShoe(1)
+	if _, err := s.DrawN(-1); err == nil {
+		t.Fatal("DrawN(-1) returned no error")
+	}
+}
+
+func TestShoeDrawNReturnsRequestedCount(t *testing.T) {
+	s := NewShoe(1)
+	cards, err := s.DrawN(5)
+	if err != nil {
+		t.Fatalf("DrawN(5): %v", err)
+	}
+	if len(cards) != 5 {
+		t.Fatalf("len(cards) = %d, want 5", len(cards))
+	}
+}
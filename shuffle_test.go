@@ -0,0 +1,49 @@
+package deck
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestShuffleKeepsEveryCard(t *testing.T) {
+	cards := Shuffle(New())
+	if len(cards) != 52 {
+		t.Fatalf("len(cards) = %d, want 52", len(cards))
+	}
+
+	sort.Slice(cards, Less(cards))
+	want := New()
+	for i := range want {
+		if cards[i] != want[i] {
+			t.Fatalf("Shuffle produced card %v at sorted position %d, want %v - Shuffle must permute, not drop or duplicate", cards[i], i, want[i])
+		}
+	}
+}
+
+func TestShuffleWithRandIsDeterministic(t *testing.T) {
+	a := New(ShuffleWithRand(rand.New(rand.NewSource(7))))
+	b := New(ShuffleWithRand(rand.New(rand.NewSource(7))))
+
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("card %d differs between two decks shuffled with the same seed: %v vs %v", i, a[i], b[i])
+		}
+	}
+}
+
+func TestShuffleWithRandDiffersAcrossSeeds(t *testing.T) {
+	a := New(ShuffleWithRand(rand.New(rand.NewSource(7))))
+	b := New(ShuffleWithRand(rand.New(rand.NewSource(8))))
+
+	same := true
+	for i := range a {
+		if a[i] != b[i] {
+			same = false
+			break
+		}
+	}
+	if same {
+		t.Fatal("decks shuffled with two different seeds produced the identical order")
+	}
+}
@@ -0,0 +1,55 @@
+package deck
+
+import "sort"
+
+// SortBy returns a New option that sorts cards using less directly,
+// without the index bookkeeping Sort's less-function-of-a-slice
+// signature requires.
+func SortBy(less func(a, b Card) bool) func([]Card) []Card {
+	return func(cards []Card) []Card {
+		sort.Slice(cards, func(i, j int) bool { return less(cards[i], cards[j]) })
+		return cards
+	}
+}
+
+// SortStableBy is SortBy using a stable sort, preserving the relative
+// order of cards less considers equal.
+func SortStableBy(less func(a, b Card) bool) func([]Card) []Card {
+	return func(cards []Card) []Card {
+		sort.SliceStable(cards, func(i, j int) bool { return less(cards[i], cards[j]) })
+		return cards
+	}
+}
+
+// IsSortedBy reports whether cards is already sorted according to less.
+func IsSortedBy(cards []Card, less func(a, b Card) bool) bool {
+	return sort.SliceIsSorted(cards, func(i, j int) bool { return less(cards[i], cards[j]) })
+}
+
+// ByRank orders cards by Rank, ignoring Suit.
+func ByRank(a, b Card) bool { return a.Rank < b.Rank }
+
+// ByRankDesc orders cards by Rank, highest first.
+func ByRankDesc(a, b Card) bool { return a.Rank > b.Rank }
+
+// BySuit orders cards by Suit, ignoring Rank.
+func BySuit(a, b Card) bool { return a.Suit < b.Suit }
+
+// Multi chains comparators into a single less function, falling
+// through to the next comparator whenever the current one considers a
+// and b equal - e.g. Multi(ByRankDesc, BySuit) sorts by rank
+// descending, ties broken by suit. Modeled on the sort package's
+// multi-key-sort example.
+func Multi(cmps ...func(a, b Card) bool) func(a, b Card) bool {
+	return func(a, b Card) bool {
+		for _, cmp := range cmps {
+			switch {
+			case cmp(a, b):
+				return true
+			case cmp(b, a):
+				return false
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,70 @@
+package deck
+
+import "testing"
+
+func TestSortByAndIsSortedBy(t *testing.T) {
+	cards := New()
+	if IsSortedBy(cards, ByRankDesc) {
+		t.Fatal("a freshly built deck is already sorted by rank descending")
+	}
+
+	sorted := SortBy(ByRankDesc)(cards)
+	if !IsSortedBy(sorted, ByRankDesc) {
+		t.Fatal("SortBy(ByRankDesc) did not leave the deck sorted by IsSortedBy's own measure")
+	}
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Rank < sorted[i].Rank {
+			t.Fatalf("position %d: rank %v comes after rank %v, want descending", i, sorted[i].Rank, sorted[i-1].Rank)
+		}
+	}
+}
+
+func TestSortStableByPreservesEqualOrder(t *testing.T) {
+	cards := []Card{
+		{Suit: Spade, Rank: Ace},
+		{Suit: Heart, Rank: Ace},
+		{Suit: Club, Rank: Ace},
+	}
+	sorted := SortStableBy(ByRank)(cards)
+	want := []Card{
+		{Suit: Spade, Rank: Ace},
+		{Suit: Heart, Rank: Ace},
+		{Suit: Club, Rank: Ace},
+	}
+	for i, c := range want {
+		if sorted[i] != c {
+			t.Fatalf("position %d = %v, want %v (stable sort must keep equal-rank cards in input order)", i, sorted[i], c)
+		}
+	}
+}
+
+func TestMultiBreaksTiesWithSecondComparator(t *testing.T) {
+	cards := []Card{
+		{Suit: Heart, Rank: King},
+		{Suit: Spade, Rank: Ace},
+		{Suit: Diamond, Rank: King},
+		{Suit: Club, Rank: Ace},
+	}
+
+	sorted := SortBy(Multi(ByRankDesc, BySuit))(cards)
+	want := []Card{
+		{Suit: Diamond, Rank: King},
+		{Suit: Heart, Rank: King},
+		{Suit: Spade, Rank: Ace},
+		{Suit: Club, Rank: Ace},
+	}
+	for i, c := range want {
+		if sorted[i] != c {
+			t.Fatalf("position %d = %v, want %v", i, sorted[i], c)
+		}
+	}
+}
+
+func TestBySuit(t *testing.T) {
+	if !BySuit(Card{Suit: Spade}, Card{Suit: Diamond}) {
+		t.Fatal("BySuit(Spade, Diamond) = false, want true (Spade sorts before Diamond)")
+	}
+	if BySuit(Card{Suit: Diamond}, Card{Suit: Spade}) {
+		t.Fatal("BySuit(Diamond, Spade) = true, want false")
+	}
+}